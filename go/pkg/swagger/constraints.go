@@ -0,0 +1,31 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swagger
+
+// Constraints carries the OpenAPI validation keywords that survive from a schema into
+// generated code, so languages can emit runtime validators in addition to type shape.
+// A nil *Constraints means the property carries no constraints beyond its type and
+// required-ness. NamedProperty.Constraints and Primitive.Constraints both use this type.
+type Constraints struct {
+	Minimum     *float64
+	Maximum     *float64
+	MinLength   *int64
+	MaxLength   *int64
+	Pattern     string
+	MinItems    *int64
+	MaxItems    *int64
+	UniqueItems bool
+	Enum        []string
+}
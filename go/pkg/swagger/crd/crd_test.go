@@ -0,0 +1,44 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crd
+
+import (
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+func TestConstraintsFor_Enum(t *testing.T) {
+	schema := apiextensionsv1.JSONSchemaProps{
+		Enum: []apiextensionsv1.JSON{
+			{Raw: []byte(`"Cluster"`)},
+			{Raw: []byte(`"Namespaced"`)},
+		},
+	}
+
+	c := constraintsFor(&schema)
+	if c == nil {
+		t.Fatalf("expected constraints for a schema with enum values")
+	}
+	if len(c.Enum) != 2 || c.Enum[0] != "Cluster" || c.Enum[1] != "Namespaced" {
+		t.Fatalf("expected enum values to be decoded from their raw JSON encoding, got %v", c.Enum)
+	}
+}
+
+func TestConstraintsFor_NoConstraints(t *testing.T) {
+	if c := constraintsFor(&apiextensionsv1.JSONSchemaProps{}); c != nil {
+		t.Fatalf("expected nil constraints for a schema with no validation keywords, got %+v", c)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package language
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/GoogleContainerTools/kpt-functions-sdk/go/pkg/swagger"
+)
+
+// ImportResolver assigns every imported swagger package a unique TypeScript import alias.
+// tsPackageAlias used to take the last three package segments and title-case them
+// (TODO(b/141927141)), so two packages whose tails collided silently produced the same alias
+// and generated code that either failed to compile or cross-linked. ImportResolver instead
+// extends the tail out one segment at a time until the alias is unique, falling back to a
+// numeric suffix if it never is.
+type ImportResolver struct {
+	aliases map[string]string
+}
+
+// newImportResolver builds the alias map for every distinct package reachable in refs, other
+// than currentPackage itself (which never needs an alias).
+func newImportResolver(currentPackage string, refs []swagger.Ref) *ImportResolver {
+	var packages []string
+	seen := map[string]bool{}
+	for _, ref := range refs {
+		if ref.Package == currentPackage || seen[ref.Package] {
+			continue
+		}
+		seen[ref.Package] = true
+		packages = append(packages, ref.Package)
+	}
+	sort.Strings(packages)
+
+	r := &ImportResolver{aliases: map[string]string{}}
+	used := map[string]bool{}
+	for _, pkg := range packages {
+		alias := uniqueAlias(pkg, used)
+		used[alias] = true
+		r.aliases[pkg] = alias
+	}
+	return r
+}
+
+// uniqueAlias finds the shortest tail of pkg's segments whose title-cased alias isn't already
+// taken, falling back to a numeric suffix on the full alias if every tail collides.
+func uniqueAlias(pkg string, used map[string]bool) string {
+	segments := strings.Split(pkg, ".")
+
+	for n := 1; n <= len(segments); n++ {
+		alias := tsAliasFromTail(segments[len(segments)-n:])
+		if !used[alias] {
+			return alias
+		}
+	}
+
+	base := tsAliasFromTail(segments)
+	for i := 2; ; i++ {
+		alias := fmt.Sprintf("%s%d", base, i)
+		if !used[alias] {
+			return alias
+		}
+	}
+}
+
+// tsAliasFromTail title-cases every segment but the first, matching the casing the generator
+// has always used for import aliases (e.g. ["api", "apps", "v1"] -> "apiAppsV1").
+func tsAliasFromTail(segments []string) string {
+	result := make([]string, len(segments))
+	for i, segment := range segments {
+		if i == 0 {
+			result[i] = segment
+			continue
+		}
+		result[i] = strings.Title(segment)
+	}
+	return strings.Join(result, "")
+}
+
+// Alias returns the import alias assigned to pkg, or "" if pkg was never registered.
+func (r *ImportResolver) Alias(pkg string) string {
+	return r.aliases[pkg]
+}
+
+// resolverFor returns the ImportResolver PrintHeader built for currentPackage's file. It is
+// looked up, not rebuilt, on every call -- PrintHeader is always rendered before the
+// definitions in its file, so by the time tsType/PrintTSConstructor/PrintTSConstructorField run
+// for that file the cache is already warm. The fallback below only matters for callers (tests,
+// mainly) that render a Ref outside the PrintHeader/PrintDefinition sequence; it is scoped to
+// currentPackage's own objects, the same ref set PrintHeader would have computed via getRefs, so
+// a cache miss can never hand out different aliases than PrintHeader did.
+func (ts *TypeScript) resolverFor(currentPackage string) *ImportResolver {
+	if ts.resolvers != nil {
+		if resolver, ok := ts.resolvers[currentPackage]; ok {
+			return resolver
+		}
+	}
+
+	var refs []swagger.Ref
+	for ref, object := range ts.RefObjects {
+		if ref.Package == currentPackage {
+			refs = append(refs, object.Imports()...)
+		}
+	}
+	return newImportResolver(currentPackage, refs)
+}
+
+// resolveAlias looks up pkg's alias in the resolver for currentPackage's file.
+func (ts *TypeScript) resolveAlias(currentPackage, pkg string) string {
+	return ts.resolverFor(currentPackage).Alias(pkg)
+}
@@ -0,0 +1,86 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package language
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/kpt-functions-sdk/go/pkg/swagger"
+)
+
+func TestImportResolver_TailCollision(t *testing.T) {
+	// "a.b.api.apps.v1" and "c.d.api.apps.v1" share the same last-three-segment tail, which
+	// is exactly the collision tsPackageAlias used to produce silently.
+	refs := []swagger.Ref{
+		{Package: "a.b.api.apps.v1", Name: "Deployment"},
+		{Package: "c.d.api.apps.v1", Name: "StatefulSet"},
+	}
+
+	resolver := newImportResolver("current", refs)
+
+	first := resolver.Alias("a.b.api.apps.v1")
+	second := resolver.Alias("c.d.api.apps.v1")
+
+	if first == "" || second == "" {
+		t.Fatalf("expected both packages to get an alias, got %q and %q", first, second)
+	}
+	if first == second {
+		t.Fatalf("expected distinct aliases for colliding tails, both got %q", first)
+	}
+}
+
+func TestImportResolver_ShortPackage(t *testing.T) {
+	// Packages with fewer than three segments used to panic-ish slice out of bounds in
+	// tsPackageAlias; the resolver should just use every segment it has.
+	refs := []swagger.Ref{
+		{Package: "v1", Name: "Pod"},
+	}
+
+	resolver := newImportResolver("current", refs)
+
+	if alias := resolver.Alias("v1"); alias != "v1" {
+		t.Fatalf("expected alias %q, got %q", "v1", alias)
+	}
+}
+
+func TestImportResolver_CurrentPackageNotAliased(t *testing.T) {
+	refs := []swagger.Ref{
+		{Package: "current", Name: "Local"},
+		{Package: "other.pkg.v1", Name: "Remote"},
+	}
+
+	resolver := newImportResolver("current", refs)
+
+	if alias := resolver.Alias("current"); alias != "" {
+		t.Fatalf("expected current package to have no alias, got %q", alias)
+	}
+	if alias := resolver.Alias("other.pkg.v1"); alias == "" {
+		t.Fatalf("expected other.pkg.v1 to get an alias")
+	}
+}
+
+func TestTypeScript_ResolverForUsesPrintHeadersCache(t *testing.T) {
+	ts := TypeScript{
+		RefObjects: map[swagger.Ref]swagger.Object{},
+		resolvers:  map[string]*ImportResolver{},
+	}
+
+	seeded := newImportResolver("current", []swagger.Ref{{Package: "other.pkg.v1", Name: "Remote"}})
+	ts.resolvers["current"] = seeded
+
+	if got := ts.resolverFor("current"); got != seeded {
+		t.Fatalf("expected resolverFor to return the resolver PrintHeader cached, not rebuild one")
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package language
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/GoogleContainerTools/kpt-functions-sdk/go/pkg/swagger"
+)
+
+// Python implements Language-specific logic for Python.
+type Python struct {
+	RefObjects map[swagger.Ref]swagger.Object
+}
+
+var _ Language = Python{}
+
+// File implements Language.
+func (py Python) File(definition swagger.Definition) string {
+	return fmt.Sprintf("%s.py", pyPackageAlias(definition.Meta().Package))
+}
+
+// PrintHeader implements Language.
+func (py Python) PrintHeader(definitions []swagger.Definition) string {
+	if len(definitions) == 0 {
+		return ""
+	}
+	currentPackage := definitions[0].Meta().Package
+
+	imports := getRefs(definitions)
+
+	packagesMap := make(map[string]bool)
+	for _, ref := range imports {
+		if ref.Package == currentPackage {
+			continue
+		}
+		packagesMap[ref.Package] = true
+	}
+	var packages []string
+	for pkg := range packagesMap {
+		packages = append(packages, pkg)
+	}
+	sort.Strings(packages)
+
+	result := []string{
+		"from dataclasses import dataclass, field",
+		"from typing import Dict, List, Optional",
+	}
+
+	for _, pkg := range packages {
+		alias := pyPackageAlias(pkg)
+		result = append(result, fmt.Sprintf("from . import %s", alias))
+	}
+
+	return strings.Join(result, "\n")
+}
+
+// PrintDefinition implements Language.
+func (py Python) PrintDefinition(definition swagger.Definition) string {
+	switch d := definition.(type) {
+	case swagger.Object:
+		return py.pythonObject(d)
+	case swagger.Alias:
+		return pythonAlias(d)
+	default:
+		panic(fmt.Sprintf("unknown deinition type %T", d))
+	}
+}
+
+// orderedForDataclass stably moves every required property ahead of the optional ones.
+// @dataclass fields without a default may not follow a field with one, and required fields are
+// emitted without a default while optional fields default to None, so schema order alone can
+// produce a class that fails to import.
+func orderedForDataclass(properties []swagger.NamedProperty) []swagger.NamedProperty {
+	ordered := make([]swagger.NamedProperty, 0, len(properties))
+	for _, property := range properties {
+		if property.Required {
+			ordered = append(ordered, property)
+		}
+	}
+	for _, property := range properties {
+		if !property.Required {
+			ordered = append(ordered, property)
+		}
+	}
+	return ordered
+}
+
+func pythonAlias(a swagger.Alias) string {
+	return fmt.Sprintf(`%s%s = %s`, printPyDescription(a.Description), a.Name, pyType(a.Package, a.Type))
+}
+
+func (py Python) pythonObject(o swagger.Object) string {
+	var fields []string
+	for _, property := range orderedForDataclass(o.NamedProperties()) {
+		fields = append(fields, Indent(PrintPyField(o.Package, property)))
+	}
+	if len(fields) == 0 {
+		fields = append(fields, Indent("pass"))
+	}
+
+	sort.Slice(o.NestedTypes, func(i, j int) bool {
+		return o.NestedTypes[i].Name < o.NestedTypes[j].Name
+	})
+	var nested []string
+	for _, t := range o.NestedTypes {
+		nested = append(nested, Indent(py.pythonObject(t)))
+	}
+
+	constants := ""
+	if len(o.GroupVersionKinds) > 0 {
+		constants = fmt.Sprintf(`
+apiVersion = %q
+group = %q
+version = %q
+kind = %q
+`, o.GroupVersionKind().APIVersion(), o.GroupVersionKind().Group, o.GroupVersionKind().Version, o.GroupVersionKind().Kind)
+	}
+
+	isGuard := ""
+	if o.GroupVersionKind() != nil {
+		isGuard = fmt.Sprintf(`
+
+def is_%s(o) -> bool:
+  return bool(o) and getattr(o, "apiVersion", None) == apiVersion and getattr(o, "kind", None) == kind`, o.Name)
+	}
+
+	namedFunc := ""
+	if o.IsKubernetesObject {
+		onlyMetaRequired := true
+		for name, p := range o.Properties {
+			if p.Required && name != "metadata" && name != "apiVersion" && name != "kind" {
+				onlyMetaRequired = false
+			}
+		}
+		if onlyMetaRequired {
+			namedFunc = fmt.Sprintf(`
+
+def named(name: str) -> "%s":
+  """Constructs a %s with metadata.name set to name."""
+  return %s(apiVersion=apiVersion, kind=kind, metadata={"name": name})`, o.Name, o.Name, o.Name)
+		}
+	}
+
+	body := strings.Join(append(fields, nested...), "\n\n")
+
+	return fmt.Sprintf(`%s@dataclass
+class %s:
+%s
+%s%s%s`, printPyDescription(o.Description), o.Name, body, constants, isGuard, namedFunc)
+}
+
+// printPyDescription formats the description as a Python comment.
+func printPyDescription(description string) string {
+	if description == "" {
+		return ""
+	}
+	parts := strings.Split(description, "\n")
+	for i, part := range parts {
+		parts[i] = fmt.Sprintf("# %s\n", part)
+	}
+	return strings.Join(parts, "")
+}
+
+// pyPackageAlias derives the alias used for `from . import <alias>` statements and the
+// generated module's own filename. It takes the last three package segments (or all of them,
+// if there are fewer) and joins them in lower snake_case, the Python convention.
+func pyPackageAlias(pkg string) string {
+	splits := strings.Split(pkg, ".")
+	if len(splits) > 3 {
+		splits = splits[len(splits)-3:]
+	}
+	return strings.ToLower(strings.Join(splits, "_"))
+}
+
+func pyType(currentPackage string, t swagger.Type) string {
+	switch t2 := t.(type) {
+	case swagger.Empty:
+		return "object"
+	case swagger.Primitive:
+		return pyPrimitive(t2)
+	case swagger.Ref:
+		if t2.Package == currentPackage {
+			return fmt.Sprintf("%q", t2.Name)
+		}
+		return fmt.Sprintf("%q", fmt.Sprintf("%s.%s", pyPackageAlias(t2.Package), t2.Name))
+	case swagger.Array:
+		return fmt.Sprintf("List[%s]", pyType(currentPackage, t2.Items))
+	case swagger.Map:
+		return fmt.Sprintf("Dict[str, %s]", pyType(currentPackage, t2.Values))
+	default:
+		panic(fmt.Sprintf("unknown Type: %T", t2))
+	}
+}
+
+func pyPrimitive(p swagger.Primitive) string {
+	switch p.Type {
+	case swagger.BOOLEAN:
+		return "bool"
+	case swagger.INTEGER:
+		return "int"
+	case swagger.NUMBER:
+		return "float"
+	case swagger.STRING:
+		return "str"
+	}
+
+	panic(fmt.Sprintf("unknown Primitive %+v", p))
+}
+
+// PrintPyField prints a single dataclass field, wrapping the type in Optional[...] when the
+// field is not required.
+func PrintPyField(currentPackage string, property swagger.NamedProperty) string {
+	t := pyType(currentPackage, property.Type)
+	if !property.Required {
+		return fmt.Sprintf(`%s%s: Optional[%s] = None`, printPyDescription(property.Description), property.Name, t)
+	}
+	return fmt.Sprintf(`%s%s: %s`, printPyDescription(property.Description), property.Name, t)
+}
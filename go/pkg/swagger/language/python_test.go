@@ -0,0 +1,47 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package language
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/GoogleContainerTools/kpt-functions-sdk/go/pkg/swagger"
+)
+
+func TestPythonObject_RequiredFieldsBeforeOptional(t *testing.T) {
+	// The optional field sorts alphabetically before the required one, mirroring schemas where
+	// alphabetical or declaration order doesn't already put required fields first.
+	o := swagger.Object{
+		Package: "io.example.v1",
+		Name:    "Widget",
+		Properties: map[string]swagger.NamedProperty{
+			"replicas": {Name: "replicas", Required: false, Type: swagger.Primitive{Type: swagger.INTEGER}},
+			"selector": {Name: "selector", Required: true, Type: swagger.Primitive{Type: swagger.STRING}},
+		},
+	}
+
+	py := Python{}
+	class := py.pythonObject(o)
+
+	selectorIdx := strings.Index(class, "selector: str")
+	replicasIdx := strings.Index(class, "replicas: Optional[int] = None")
+	if selectorIdx == -1 || replicasIdx == -1 {
+		t.Fatalf("expected both fields to be rendered, got:\n%s", class)
+	}
+	if selectorIdx > replicasIdx {
+		t.Fatalf("expected required field `selector` to precede optional field `replicas`, got:\n%s", class)
+	}
+}
@@ -25,17 +25,23 @@ import (
 // TypeScript implements Language-specific logic for TypeScript.
 type TypeScript struct {
 	RefObjects map[swagger.Ref]swagger.Object
+
+	// resolvers caches the per-file ImportResolver PrintHeader built for each package, keyed
+	// by that package. PrintHeader lazily initializes this itself, so every TypeScript value is
+	// usable as-is -- callers don't need to remember to pre-populate it, and the resolver the
+	// body renders with can never be a different one than PrintHeader cached.
+	resolvers map[string]*ImportResolver
 }
 
-var _ Language = TypeScript{}
+var _ Language = &TypeScript{}
 
 // File implements Language.
-func (ts TypeScript) File(definition swagger.Definition) string {
+func (ts *TypeScript) File(definition swagger.Definition) string {
 	return fmt.Sprintf("%s.ts", definition.Meta().Package)
 }
 
 // PrintHeader implements Language.
-func (ts TypeScript) PrintHeader(definitions []swagger.Definition) string {
+func (ts *TypeScript) PrintHeader(definitions []swagger.Definition) string {
 	if len(definitions) == 0 {
 		return ""
 	}
@@ -43,6 +49,12 @@ func (ts TypeScript) PrintHeader(definitions []swagger.Definition) string {
 
 	imports := getRefs(definitions)
 
+	resolver := newImportResolver(currentPackage, imports)
+	if ts.resolvers == nil {
+		ts.resolvers = map[string]*ImportResolver{}
+	}
+	ts.resolvers[currentPackage] = resolver
+
 	packagesMap := make(map[string]bool)
 	for _, ref := range imports {
 		if ref.Package == currentPackage {
@@ -65,13 +77,37 @@ func (ts TypeScript) PrintHeader(definitions []swagger.Definition) string {
 			hasKubernetesObject = true
 		}
 	}
+
+	hasValidation := false
+	for _, def := range definitions {
+		if o, ok := def.(swagger.Object); ok && objectHasValidation(o) {
+			hasValidation = true
+		}
+	}
+
+	hasPaths := false
+	for _, def := range definitions {
+		if o, ok := def.(swagger.Object); ok && objectHasPaths(o) {
+			hasPaths = true
+		}
+	}
+
+	var symbols []string
 	if hasKubernetesObject {
-		result = append(result, "import { KubernetesObject } from '@googlecontainertools/kpt-functions';")
+		symbols = append(symbols, "KubernetesObject")
+	}
+	if hasValidation {
+		symbols = append(symbols, "ValidationError")
+	}
+	if hasPaths {
+		symbols = append(symbols, "PathExpr")
+	}
+	if len(symbols) > 0 {
+		result = append(result, fmt.Sprintf("import { %s } from '@googlecontainertools/kpt-functions';", strings.Join(symbols, ", ")))
 	}
 
 	for _, pkg := range packages {
-		alias := tsPackageAlias(pkg)
-		result = append(result, fmt.Sprintf("import * as %s from './%s';", alias, pkg))
+		result = append(result, fmt.Sprintf("import * as %s from './%s';", resolver.Alias(pkg), pkg))
 	}
 
 	return strings.Join(result, "\n")
@@ -97,26 +133,26 @@ func getRefs(definitions []swagger.Definition) []swagger.Ref {
 }
 
 // PrintDefinition implements Language.
-func (ts TypeScript) PrintDefinition(definition swagger.Definition) string {
+func (ts *TypeScript) PrintDefinition(definition swagger.Definition) string {
 	switch d := definition.(type) {
 	case swagger.Object:
 		return ts.typeScriptObject(d)
 	case swagger.Alias:
-		return typeScriptAlias(d)
+		return ts.typeScriptAlias(d)
 	default:
 		panic(fmt.Sprintf("unknown deinition type %T", d))
 	}
 }
 
-func typeScriptAlias(a swagger.Alias) string {
-	return fmt.Sprintf(`%sexport type %s = %s;`, printDescription(a.Description), a.Name, tsType(a.Package, a.Type))
+func (ts *TypeScript) typeScriptAlias(a swagger.Alias) string {
+	return fmt.Sprintf(`%sexport type %s = %s;`, printDescription(a.Description), a.Name, ts.tsType(a.Package, a.Type))
 }
 
-func (ts TypeScript) typeScriptObject(o swagger.Object) string {
+func (ts *TypeScript) typeScriptObject(o swagger.Object) string {
 	var fields []string
 	var constructors []string
 	for _, property := range o.NamedProperties() {
-		fields = append(fields, PrintTSTypesField(o.Package, property))
+		fields = append(fields, ts.PrintTSTypesField(o.Package, property))
 
 		if len(o.GroupVersionKinds) > 0 {
 			switch property.Name {
@@ -162,9 +198,19 @@ constructor(desc%s: %s) {%s
 		implements = " implements KubernetesObject"
 	}
 
+	validate := ts.printTSValidate(o)
+	validateFunc := ""
+	if validate != "" && o.GroupVersionKind() != nil {
+		validateFunc = fmt.Sprintf(`
+
+export function validate%s(o: %s): ValidationError[] {
+  return o.validate();
+}`, o.Name, o.Name)
+	}
+
 	return fmt.Sprintf(`%sexport class %s%s {
-%s%s
-}%s%s`, printDescription(o.Description), o.Name, implements, Indent(strings.Join(fields, "\n\n")), constructor, isType, ts.printNamespaceClasses(o))
+%s%s%s
+}%s%s%s`, printDescription(o.Description), o.Name, implements, Indent(strings.Join(fields, "\n\n")), constructor, validate, isType, validateFunc, ts.printNamespaceClasses(o))
 }
 
 // printDescription formats the description for TypeScript.
@@ -181,7 +227,7 @@ func printDescription(description string) string {
 	return strings.Join(parts, "")
 }
 
-func (ts TypeScript) printNamespaceClasses(o swagger.Object) string {
+func (ts *TypeScript) printNamespaceClasses(o swagger.Object) string {
 
 	if len(o.NestedTypes) == 0 && !o.IsKubernetesObject && len(o.GroupVersionKinds) == 0 {
 		return ""
@@ -190,7 +236,7 @@ func (ts TypeScript) printNamespaceClasses(o swagger.Object) string {
 
 	var classes []string
 	if o.GroupVersionKind() != nil {
-		classes = append(classes, Indent(printInterface(o)))
+		classes = append(classes, Indent(ts.printInterface(o)))
 	}
 
 	sort.Slice(o.NestedTypes, func(i, j int) bool {
@@ -229,15 +275,86 @@ export function named(name: string): %s {
 		}
 	}
 
+	paths := ts.printTSPaths(o)
+
 	return fmt.Sprintf(`
 
 export namespace %s {
-%s%s%s
-}`, namespace[len(namespace)-1], constants, namedFunc, strings.Join(classes, "\n"))
+%s%s%s%s
+}`, namespace[len(namespace)-1], constants, namedFunc, paths, strings.Join(classes, "\n"))
+}
+
+// objectHasPaths reports whether o, or any of its nested types, will actually emit a `paths`
+// constant -- i.e. it clears printNamespaceClasses's namespace gate and has at least one named
+// property. PrintHeader uses this to decide whether the file needs to import PathExpr.
+func objectHasPaths(o swagger.Object) bool {
+	qualifiesForNamespace := len(o.NestedTypes) > 0 || o.IsKubernetesObject || len(o.GroupVersionKinds) > 0
+	if qualifiesForNamespace && len(o.NamedProperties()) > 0 {
+		return true
+	}
+	for _, nested := range o.NestedTypes {
+		if objectHasPaths(nested) {
+			return true
+		}
+	}
+	return false
+}
+
+// printTSPaths emits a `paths` constant mirroring o's field tree, with typed PathExpr<T>
+// leaves carrying the compiled JSONPath string. Arrays and maps become indexing functions so
+// callers can write e.g. Deployment.paths.spec.template.spec.containers(0).image. Recursion
+// into non-Kubernetes Refs is guarded by visited so self-referential schemas terminate.
+func (ts *TypeScript) printTSPaths(o swagger.Object) string {
+	if len(o.NamedProperties()) == 0 {
+		return ""
+	}
+
+	visited := map[swagger.Ref]bool{o.Meta().ToRef(): true}
+	var entries []string
+	for _, property := range o.NamedProperties() {
+		entries = append(entries, fmt.Sprintf("%s: %s,", property.Name, ts.tsPathNode(o.Package, property.Type, "$."+property.Name, 0, visited)))
+	}
+
+	return Indent(fmt.Sprintf(`export const paths = {
+%s
+};
+
+`, Indent(strings.Join(entries, "\n"))))
+}
+
+// tsPathNode renders the PathExpr tree (or object literal, or indexing function) for t at the
+// given compiled JSONPath, recursing into Ref/Array/Map.
+func (ts *TypeScript) tsPathNode(currentPackage string, t swagger.Type, path string, depth int, visited map[swagger.Ref]bool) string {
+	switch t2 := t.(type) {
+	case swagger.Empty, swagger.Primitive:
+		return fmt.Sprintf("new PathExpr<%s>(`%s`)", ts.tsType(currentPackage, t), path)
+	case swagger.Ref:
+		obj, ok := ts.RefObjects[t2]
+		if !ok || visited[t2] {
+			return fmt.Sprintf("new PathExpr<%s>(`%s`)", ts.tsType(currentPackage, t), path)
+		}
+		visited[t2] = true
+		var fields []string
+		for _, property := range obj.NamedProperties() {
+			fields = append(fields, fmt.Sprintf("%s: %s,", property.Name, ts.tsPathNode(obj.Package, property.Type, path+"."+property.Name, depth, visited)))
+		}
+		delete(visited, t2)
+		return fmt.Sprintf("{\n%s\n}", Indent(strings.Join(fields, "\n")))
+	case swagger.Array:
+		index := fmt.Sprintf("i%d", depth)
+		item := ts.tsPathNode(currentPackage, t2.Items, fmt.Sprintf("%s[${%s}]", path, index), depth+1, visited)
+		return fmt.Sprintf("(%s: number | '*' = '*') => (%s)", index, item)
+	case swagger.Map:
+		key := fmt.Sprintf("k%d", depth)
+		item := ts.tsPathNode(currentPackage, t2.Values, fmt.Sprintf("%s[${%s}]", path, key), depth+1, visited)
+		return fmt.Sprintf("(%s: string) => (%s)", key, item)
+	default:
+		panic(fmt.Sprintf("unknown Type: %T", t2))
+	}
 }
 
 // printInterface prints the interface for KubernetesObjects.
-func printInterface(o swagger.Object) string {
+func (ts *TypeScript) printInterface(o swagger.Object) string {
 	var properties []string
 	for _, property := range o.NamedProperties() {
 		if o.GroupVersionKind() != nil {
@@ -245,45 +362,28 @@ func printInterface(o swagger.Object) string {
 				continue
 			}
 		}
-		properties = append(properties, PrintTSInterfacesField(o.Package, property))
+		properties = append(properties, ts.PrintTSInterfacesField(o.Package, property))
 	}
 	return fmt.Sprintf(`%sexport interface Interface {
 %s
 }`, printDescription(o.Description), Indent(strings.Join(properties, "\n\n")))
 }
 
-func tsPackageAlias(pkg string) string {
-	splits := strings.Split(pkg, ".")
-	splits = splits[len(splits)-3:]
-	for i, split := range splits {
-		if i == 0 {
-			continue
-		}
-		splits[i] = strings.Title(split)
-	}
-	// Assumes packages have at least three elements. This assumption is not guaranteed to be true by OpenAPI, but is
-	// unlikely to ever be false because of package naming conventions.
-	return strings.Join(splits, "")
-}
-
-func tsType(currentPackage string, t swagger.Type) string {
+func (ts *TypeScript) tsType(currentPackage string, t swagger.Type) string {
 	switch t2 := t.(type) {
 	case swagger.Empty:
 		return "object"
 	case swagger.Primitive:
 		return tsPrimitive(t2)
 	case swagger.Ref:
-		// TODO(b/141927141): Handle imported name collisions.
-		//  As-is, a collision happens when the last three elements of package AND the Kind are the same for two
-		//  different Definitions. This is exceedingly rare, and will cause circular references if it occurs.
 		if t2.Package == currentPackage {
 			return t2.Name
 		}
-		return fmt.Sprintf("%s.%s", tsPackageAlias(t2.Package), t2.Name)
+		return fmt.Sprintf("%s.%s", ts.resolveAlias(currentPackage, t2.Package), t2.Name)
 	case swagger.Array:
-		return fmt.Sprintf("%s[]", tsType(currentPackage, t2.Items))
+		return fmt.Sprintf("%s[]", ts.tsType(currentPackage, t2.Items))
 	case swagger.Map:
-		return fmt.Sprintf("{[key: string]: %s}", tsType(currentPackage, t2.Values))
+		return fmt.Sprintf("{[key: string]: %s}", ts.tsType(currentPackage, t2.Values))
 	default:
 		panic(fmt.Sprintf("unknown Type: %T", t2))
 	}
@@ -303,29 +403,24 @@ func tsPrimitive(p swagger.Primitive) string {
 }
 
 // PrintTSTypesField prints the property for the types.ts file for TypeScript.
-func PrintTSTypesField(currentPackage string, property swagger.NamedProperty) string {
+func (ts *TypeScript) PrintTSTypesField(currentPackage string, property swagger.NamedProperty) string {
 	optional := ""
 	if !property.Required {
 		optional = "?"
 	}
-	return fmt.Sprintf(`%spublic %s%s: %s;`, printDescription(property.Description), property.Name, optional, tsType(currentPackage, property.Type))
+	return fmt.Sprintf(`%spublic %s%s: %s;`, printDescription(property.Description), property.Name, optional, ts.tsType(currentPackage, property.Type))
 }
 
 // PrintTSConstructorField prints the line in the constructor setting this field.
-func (ts TypeScript) PrintTSConstructorField(currentPackage string, property swagger.NamedProperty) string {
+func (ts *TypeScript) PrintTSConstructorField(currentPackage string, property swagger.NamedProperty) string {
 	var value string
 	if property.OverrideValue != "" {
 		value = property.OverrideValue
 	} else {
-		value = ts.PrintTSConstructor(currentPackage, property.Type, "desc."+property.Name)
-		if !property.Required {
-			if array, isArray := property.Type.(swagger.Array); isArray {
-				if ref, isRef := array.Items.(swagger.Ref); isRef {
-					if swagger.IsKubernetesObject(ts.RefObjects, ref) {
-						value = fmt.Sprintf("(desc.%s !== undefined) ? %s : undefined", property.Name, value)
-					}
-				}
-			}
+		expr, wraps := ts.PrintTSConstructor(currentPackage, property.Type, "desc."+property.Name)
+		value = expr
+		if !property.Required && wraps {
+			value = fmt.Sprintf("(desc.%s !== undefined) ? %s : undefined", property.Name, expr)
 		}
 	}
 	return fmt.Sprintf(`
@@ -333,38 +428,213 @@ this.%s = %s;`, property.Name, value)
 }
 
 // PrintTSInterfacesField prints the property for the interfaces.ts file for TypeScript.
-func PrintTSInterfacesField(currentPackage string, property swagger.NamedProperty) string {
+func (ts *TypeScript) PrintTSInterfacesField(currentPackage string, property swagger.NamedProperty) string {
 	optional := ""
 	if !property.Required {
 		optional = "?"
 	}
-	return fmt.Sprintf(`%s%s%s: %s;`, printDescription(property.Description), property.Name, optional, tsType(currentPackage, property.Type))
+	return fmt.Sprintf(`%s%s%s: %s;`, printDescription(property.Description), property.Name, optional, ts.tsType(currentPackage, property.Type))
 }
 
-func (ts TypeScript) PrintTSConstructor(currentPackage string, t swagger.Type, field string) string {
+// PrintTSConstructor returns the expression that assigns field into a constructor, along with
+// whether that expression actually transforms field (as opposed to passing it through
+// unchanged). The caller needs the latter to decide whether an optional field needs an
+// undefined-guard around the transform. Recursion into Array/Map means a KubernetesObject Ref
+// is rebuilt into a real class instance no matter how deep it is nested - map values, arrays of
+// arrays, maps of arrays, and so on.
+func (ts *TypeScript) PrintTSConstructor(currentPackage string, t swagger.Type, field string) (string, bool) {
 	switch t2 := t.(type) {
 	case swagger.Empty, swagger.Primitive:
-		return field
+		return field, false
 	case swagger.Ref:
 		if swagger.IsKubernetesObject(ts.RefObjects, t2) {
 			if t2.Package == currentPackage {
-				return fmt.Sprintf("new %s(%s)", t2.Name, field)
+				return fmt.Sprintf("new %s(%s)", t2.Name, field), true
 			}
-			return fmt.Sprintf("new %s.%s(%s)", tsPackageAlias(t2.Package), t2.Name, field)
+			return fmt.Sprintf("new %s.%s(%s)", ts.resolveAlias(currentPackage, t2.Package), t2.Name, field), true
 		}
-		return field
+		return field, false
+	case swagger.Array:
+		item, wraps := ts.PrintTSConstructor(currentPackage, t2.Items, "i")
+		if !wraps {
+			return field, false
+		}
+		return fmt.Sprintf("%s.map((i) => %s)", field, item), true
+	case swagger.Map:
+		value, wraps := ts.PrintTSConstructor(currentPackage, t2.Values, "v")
+		if !wraps {
+			return field, false
+		}
+		return fmt.Sprintf("Object.fromEntries(Object.entries(%s).map(([k, v]) => [k, %s]))", field, value), true
+	default:
+		panic(fmt.Sprintf("unkown type: %T", t2))
+	}
+}
+
+// objectHasValidation reports whether o or any of its nested types carries constraints that
+// would produce a non-empty validate() method.
+func objectHasValidation(o swagger.Object) bool {
+	for _, property := range o.NamedProperties() {
+		if property.Constraints != nil {
+			return true
+		}
+	}
+	for _, nested := range o.NestedTypes {
+		if objectHasValidation(nested) {
+			return true
+		}
+	}
+	return false
+}
+
+// constraintCheck is one table-driven OpenAPI validation keyword: applies reports whether the
+// keyword is set on a given Constraints, line renders the corresponding guard.
+type constraintCheck struct {
+	applies func(c swagger.Constraints) bool
+	line    func(path, field string, c swagger.Constraints) string
+}
+
+var constraintChecks = []constraintCheck{
+	{
+		applies: func(c swagger.Constraints) bool { return c.Minimum != nil },
+		line: func(path, field string, c swagger.Constraints) string {
+			return fmt.Sprintf(`if (%s !== undefined && %s < %v) { errors.push({path: %q, message: 'must be >= %v'}); }`, field, field, *c.Minimum, path, *c.Minimum)
+		},
+	},
+	{
+		applies: func(c swagger.Constraints) bool { return c.Maximum != nil },
+		line: func(path, field string, c swagger.Constraints) string {
+			return fmt.Sprintf(`if (%s !== undefined && %s > %v) { errors.push({path: %q, message: 'must be <= %v'}); }`, field, field, *c.Maximum, path, *c.Maximum)
+		},
+	},
+	{
+		applies: func(c swagger.Constraints) bool { return c.MinLength != nil },
+		line: func(path, field string, c swagger.Constraints) string {
+			return fmt.Sprintf(`if (%s !== undefined && %s.length < %d) { errors.push({path: %q, message: 'must have length >= %d'}); }`, field, field, *c.MinLength, path, *c.MinLength)
+		},
+	},
+	{
+		applies: func(c swagger.Constraints) bool { return c.MaxLength != nil },
+		line: func(path, field string, c swagger.Constraints) string {
+			return fmt.Sprintf(`if (%s !== undefined && %s.length > %d) { errors.push({path: %q, message: 'must have length <= %d'}); }`, field, field, *c.MaxLength, path, *c.MaxLength)
+		},
+	},
+	{
+		applies: func(c swagger.Constraints) bool { return c.Pattern != "" },
+		line: func(path, field string, c swagger.Constraints) string {
+			return fmt.Sprintf(`if (%s !== undefined && !%s.test(%s)) { errors.push({path: %q, message: 'must match pattern %s'}); }`, field, tsRegexLiteral(c.Pattern), field, path, c.Pattern)
+		},
+	},
+	{
+		applies: func(c swagger.Constraints) bool { return c.MinItems != nil },
+		line: func(path, field string, c swagger.Constraints) string {
+			return fmt.Sprintf(`if (%s !== undefined && %s.length < %d) { errors.push({path: %q, message: 'must have at least %d items'}); }`, field, field, *c.MinItems, path, *c.MinItems)
+		},
+	},
+	{
+		applies: func(c swagger.Constraints) bool { return c.MaxItems != nil },
+		line: func(path, field string, c swagger.Constraints) string {
+			return fmt.Sprintf(`if (%s !== undefined && %s.length > %d) { errors.push({path: %q, message: 'must have at most %d items'}); }`, field, field, *c.MaxItems, path, *c.MaxItems)
+		},
+	},
+	{
+		applies: func(c swagger.Constraints) bool { return c.UniqueItems },
+		line: func(path, field string, c swagger.Constraints) string {
+			return fmt.Sprintf(`if (%s !== undefined && new Set(%s).size !== %s.length) { errors.push({path: %q, message: 'must not contain duplicate items'}); }`, field, field, field, path)
+		},
+	},
+	{
+		applies: func(c swagger.Constraints) bool { return len(c.Enum) > 0 },
+		line: func(path, field string, c swagger.Constraints) string {
+			return fmt.Sprintf(`if (%s !== undefined && ![%s].includes(String(%s))) { errors.push({path: %q, message: 'must be one of %s'}); }`, field, tsEnumLiteral(c.Enum), field, path, strings.Join(c.Enum, ", "))
+		},
+	},
+}
+
+func tsRegexLiteral(pattern string) string {
+	return fmt.Sprintf("/%s/", strings.ReplaceAll(pattern, "/", `\/`))
+}
+
+func tsEnumLiteral(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// printTSValidate emits the validate(): ValidationError[] method for o; typeScriptObject adds
+// the module-level validate<Kind> convenience function for GVK objects after the class. It
+// returns "" if o carries no constraints anywhere in its tree, so classes with no validation
+// keep their current shape.
+func (ts *TypeScript) printTSValidate(o swagger.Object) string {
+	if !objectHasValidation(o) {
+		return ""
+	}
+
+	var checks []string
+	for _, property := range o.NamedProperties() {
+		if check := ts.printTSValidateField(o.Package, "", property); check != "" {
+			checks = append(checks, Indent(check))
+		}
+	}
+
+	method := Indent(fmt.Sprintf(`
+
+validate(): ValidationError[] {
+  const errors: ValidationError[] = [];
+%s
+  return errors;
+}`, strings.Join(checks, "\n")))
+
+	return method
+}
+
+// printTSValidateField renders the constraint checks and any recursive validate() calls for a
+// single property, using a JSON-pointer path rooted at pathPrefix.
+func (ts *TypeScript) printTSValidateField(currentPackage, pathPrefix string, property swagger.NamedProperty) string {
+	field := "this." + property.Name
+	path := pathPrefix + "/" + property.Name
+
+	var lines []string
+	if property.Constraints != nil {
+		for _, check := range constraintChecks {
+			if check.applies(*property.Constraints) {
+				lines = append(lines, check.line(path, field, *property.Constraints))
+			}
+		}
+	}
+	lines = append(lines, ts.printTSValidateNested(currentPackage, path, field, property.Type)...)
+
+	return strings.Join(lines, "\n")
+}
+
+// printTSValidateNested recurses into Ref/Array/Map values so that nested objects' own
+// validate() errors are folded in with their path rewritten relative to the parent field. The
+// nested class's validate is called through its prototype, because constructors pass
+// non-KubernetesObject values through as plain objects that have no validate() of their own.
+func (ts *TypeScript) printTSValidateNested(currentPackage, path, field string, t swagger.Type) []string {
+	switch t2 := t.(type) {
+	case swagger.Ref:
+		if obj, ok := ts.RefObjects[t2]; ok && objectHasValidation(obj) {
+			return []string{fmt.Sprintf(`if (%s !== undefined) { errors.push(...%s.prototype.validate.call(%s).map((e) => ({...e, path: %q + e.path}))); }`, field, ts.tsType(currentPackage, t2), field, path)}
+		}
+		return nil
 	case swagger.Array:
 		if ref, isRef := t2.Items.(swagger.Ref); isRef {
-			if swagger.IsKubernetesObject(ts.RefObjects, ref) {
-				// TODO(b/141928661): Does not work on arrays of KubernetesObjects which contain arrays of KubernetesObjects.
-				return fmt.Sprintf("%s.map((i) => %s)", field, ts.PrintTSConstructor(currentPackage, t2.Items, "i"))
+			if obj, ok := ts.RefObjects[ref]; ok && objectHasValidation(obj) {
+				return []string{fmt.Sprintf(`if (%s !== undefined) { %s.forEach((v, i) => errors.push(...%s.prototype.validate.call(v).map((e) => ({...e, path: %q + '/' + i + e.path})))); }`, field, field, ts.tsType(currentPackage, ref), path)}
 			}
 		}
-		return field
+		return nil
 	case swagger.Map:
-		// TODO(b/141928662): Does not work when the values of the map are KubernetesObjects.
-		return field
+		if ref, isRef := t2.Values.(swagger.Ref); isRef {
+			if obj, ok := ts.RefObjects[ref]; ok && objectHasValidation(obj) {
+				return []string{fmt.Sprintf(`if (%s !== undefined) { Object.entries(%s).forEach(([k, v]) => errors.push(...%s.prototype.validate.call(v).map((e) => ({...e, path: %q + '/' + k + e.path})))); }`, field, field, ts.tsType(currentPackage, ref), path)}
+			}
+		}
+		return nil
 	default:
-		panic(fmt.Sprintf("unkown type: %T", t2))
+		return nil
 	}
 }
@@ -0,0 +1,94 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package language
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/GoogleContainerTools/kpt-functions-sdk/go/pkg/swagger"
+)
+
+func newPodTypeScript() TypeScript {
+	podRef := swagger.Ref{Package: "io.k8s.api.core.v1", Name: "Pod"}
+	return TypeScript{
+		RefObjects: map[swagger.Ref]swagger.Object{
+			podRef: {Package: podRef.Package, Name: podRef.Name, IsKubernetesObject: true},
+		},
+	}
+}
+
+func TestPrintTSConstructor_MapOfKubernetesObject(t *testing.T) {
+	ts := newPodTypeScript()
+	podRef := swagger.Ref{Package: "io.k8s.api.core.v1", Name: "Pod"}
+
+	// map<string, Pod>
+	typ := swagger.Map{Values: podRef}
+
+	expr, wraps := ts.PrintTSConstructor(podRef.Package, typ, "desc.pods")
+	if !wraps {
+		t.Fatalf("expected map<string, Pod> to require wrapping")
+	}
+	if !strings.Contains(expr, "Object.fromEntries") || !strings.Contains(expr, "new Pod(") {
+		t.Fatalf("expected map values to be rebuilt into Pod instances, got %q", expr)
+	}
+}
+
+func TestPrintTSConstructor_ArrayOfArrayOfKubernetesObject(t *testing.T) {
+	ts := newPodTypeScript()
+	podRef := swagger.Ref{Package: "io.k8s.api.core.v1", Name: "Pod"}
+
+	// Pod[][]
+	typ := swagger.Array{Items: swagger.Array{Items: podRef}}
+
+	expr, wraps := ts.PrintTSConstructor(podRef.Package, typ, "desc.podGroups")
+	if !wraps {
+		t.Fatalf("expected Pod[][] to require wrapping")
+	}
+	if strings.Count(expr, ".map((i) => ") != 2 {
+		t.Fatalf("expected two nested .map() calls for Pod[][], got %q", expr)
+	}
+	if !strings.Contains(expr, "new Pod(") {
+		t.Fatalf("expected innermost items to be rebuilt into Pod instances, got %q", expr)
+	}
+}
+
+func TestPrintTSConstructor_MapOfArrayOfKubernetesObject(t *testing.T) {
+	ts := newPodTypeScript()
+	podRef := swagger.Ref{Package: "io.k8s.api.core.v1", Name: "Pod"}
+
+	// map<string, Pod[]>
+	typ := swagger.Map{Values: swagger.Array{Items: podRef}}
+
+	expr, wraps := ts.PrintTSConstructor(podRef.Package, typ, "desc.podsByName")
+	if !wraps {
+		t.Fatalf("expected map<string, Pod[]> to require wrapping")
+	}
+	if !strings.Contains(expr, "Object.fromEntries") || !strings.Contains(expr, ".map((i) => new Pod(i))") {
+		t.Fatalf("expected map values to be arrays of rebuilt Pod instances, got %q", expr)
+	}
+}
+
+func TestPrintTSConstructor_PlainValuesPassThrough(t *testing.T) {
+	ts := newPodTypeScript()
+
+	expr, wraps := ts.PrintTSConstructor("current", swagger.Map{Values: swagger.Primitive{Type: swagger.STRING}}, "desc.labels")
+	if wraps {
+		t.Fatalf("expected map<string, string> to pass through unchanged")
+	}
+	if expr != "desc.labels" {
+		t.Fatalf("expected pass-through expression, got %q", expr)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package language
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/GoogleContainerTools/kpt-functions-sdk/go/pkg/swagger"
+)
+
+func TestPrintHeader_ImportsPathExprWhenPathsAreEmitted(t *testing.T) {
+	o := swagger.Object{
+		Package:            "io.example.v1",
+		Name:               "Widget",
+		IsKubernetesObject: true,
+		GroupVersionKinds: []swagger.GroupVersionKind{
+			{Group: "example.com", Version: "v1", Kind: "Widget"},
+		},
+		Properties: map[string]swagger.NamedProperty{
+			"replicas": {Name: "replicas", Required: true, Type: swagger.Primitive{Type: swagger.INTEGER}},
+		},
+	}
+
+	ts := TypeScript{RefObjects: map[swagger.Ref]swagger.Object{o.Meta().ToRef(): o}}
+	header := ts.PrintHeader([]swagger.Definition{o})
+
+	if !strings.Contains(header, "PathExpr") {
+		t.Fatalf("expected header to import PathExpr, got %q", header)
+	}
+	if !strings.Contains(header, "KubernetesObject") {
+		t.Fatalf("expected header to import KubernetesObject, got %q", header)
+	}
+}
+
+func TestPrintHeader_CachesResolverEvenWithoutCallerInit(t *testing.T) {
+	// ts.resolvers is nil here -- PrintHeader must still populate it so that resolveAlias, called
+	// while rendering the body right after, sees the exact resolver PrintHeader used rather than
+	// rebuilding a possibly different one from ts.RefObjects.
+	ts := TypeScript{}
+
+	widget := swagger.Ref{Package: "io.example.v1", Name: "Widget"}
+	gadget := swagger.Ref{Package: "io.other.v1", Name: "Gadget"}
+	o := swagger.Object{
+		Package: "io.example.v1",
+		Name:    "Widget",
+		Properties: map[string]swagger.NamedProperty{
+			"gadget": {Name: "gadget", Required: true, Type: swagger.Ref{Package: gadget.Package, Name: gadget.Name}},
+		},
+	}
+
+	header := ts.PrintHeader([]swagger.Definition{o})
+	alias := ts.resolveAlias(widget.Package, gadget.Package)
+
+	if alias == "" {
+		t.Fatalf("expected resolveAlias to find an alias for %s after PrintHeader ran", gadget.Package)
+	}
+	if !strings.Contains(header, fmt.Sprintf("import * as %s from './%s';", alias, gadget.Package)) {
+		t.Fatalf("expected header's import alias to match the one resolveAlias returns, got header %q and alias %q", header, alias)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package language
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/GoogleContainerTools/kpt-functions-sdk/go/pkg/swagger"
+)
+
+func TestPrintTSValidate_EmitsConstraintChecks(t *testing.T) {
+	minLength := int64(1)
+	maximum := float64(65535)
+
+	o := swagger.Object{
+		Package: "io.example.v1",
+		Name:    "Widget",
+		Properties: map[string]swagger.NamedProperty{
+			"name": {
+				Name:        "name",
+				Required:    true,
+				Type:        swagger.Primitive{Type: swagger.STRING},
+				Constraints: &swagger.Constraints{MinLength: &minLength},
+			},
+			"port": {
+				Name:        "port",
+				Required:    false,
+				Type:        swagger.Primitive{Type: swagger.NUMBER},
+				Constraints: &swagger.Constraints{Maximum: &maximum},
+			},
+		},
+	}
+
+	ts := TypeScript{}
+
+	if !objectHasValidation(o) {
+		t.Fatalf("expected objectHasValidation to find the constraints on Widget")
+	}
+
+	body := ts.printTSValidate(o)
+	if body == "" {
+		t.Fatalf("expected a non-empty validate() method")
+	}
+	if !strings.Contains(body, "validate(): ValidationError[]") {
+		t.Fatalf("expected a validate() method signature, got %q", body)
+	}
+	if !strings.Contains(body, "this.name.length < 1") {
+		t.Fatalf("expected a minLength check for name, got %q", body)
+	}
+	if !strings.Contains(body, "this.port > 65535") {
+		t.Fatalf("expected a maximum check for port, got %q", body)
+	}
+}
+
+func TestPrintTSValidate_NoConstraintsProducesNothing(t *testing.T) {
+	o := swagger.Object{
+		Package: "io.example.v1",
+		Name:    "Plain",
+		Properties: map[string]swagger.NamedProperty{
+			"name": {Name: "name", Required: true, Type: swagger.Primitive{Type: swagger.STRING}},
+		},
+	}
+
+	ts := TypeScript{}
+	if body := ts.printTSValidate(o); body != "" {
+		t.Fatalf("expected no validate() method for a schema without constraints, got %q", body)
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swagger
+
+// Type is implemented by every value a NamedProperty or Alias can hold: Empty, Primitive, Ref,
+// Array, and Map.
+type Type interface{}
+
+// PrimitiveType enumerates the OpenAPI scalar types a Primitive can hold.
+type PrimitiveType int
+
+const (
+	BOOLEAN PrimitiveType = iota
+	INTEGER
+	NUMBER
+	STRING
+)
+
+// NamedProperty is a single field on an Object: its name, type, and whether the OpenAPI schema
+// marked it required. Constraints carries any validation keywords (minimum, pattern, enum, ...)
+// the schema declared for this property, and is nil when the property carries none.
+type NamedProperty struct {
+	Name          string
+	Required      bool
+	Description   string
+	Type          Type
+	OverrideValue string
+	Constraints   *Constraints
+}
+
+// Primitive is a scalar OpenAPI type. Constraints can carry validation keywords declared
+// directly on the schema for this value (as opposed to on the NamedProperty wrapping it, which
+// matters for Array/Map items that don't have their own NamedProperty). The CRD translator
+// in package crd leaves it nil, and the generated TypeScript validate() methods check only
+// NamedProperty.Constraints.
+type Primitive struct {
+	Type        PrimitiveType
+	Constraints *Constraints
+}